@@ -0,0 +1,20 @@
+// Package containers defines the shared interface implemented by every
+// data structure in this module, so that generic helpers (sorting,
+// serialization, and so on) can operate on any of them interchangeably.
+package containers
+
+// Container is the common base interface for every data structure in this
+// module. It captures the handful of operations that make sense
+// regardless of the underlying structure (lists, heaps, trees, ...).
+type Container[T any] interface {
+	// Empty reports whether the container holds no elements.
+	Empty() bool
+	// Size returns the number of elements in the container.
+	Size() int
+	// Clear removes all elements from the container.
+	Clear()
+	// Values returns a slice of all elements currently in the container.
+	Values() []T
+	// String returns a human-readable representation of the container.
+	String() string
+}
@@ -0,0 +1,51 @@
+// Package serialization provides generic (de)serialization helpers for any
+// data structure implementing containers.Container[T], so individual
+// container types only need a one-line wrapper to gain JSON support.
+package serialization
+
+import (
+	"encoding/json"
+
+	"github.com/Sergey-Polishchenko/go-data-structures/containers"
+)
+
+// JSONSerializer is implemented by containers that can render themselves
+// as JSON.
+type JSONSerializer[T any] interface {
+	ToJSON() ([]byte, error)
+}
+
+// JSONDeserializer is implemented by containers that can repopulate
+// themselves from JSON produced by a JSONSerializer.
+type JSONDeserializer[T any] interface {
+	FromJSON(data []byte) error
+}
+
+// Appendable is the subset of a mutable container needed to repopulate it
+// from a decoded slice of values: Clear the existing contents, then Add
+// the decoded ones back in.
+type Appendable[T any] interface {
+	containers.Container[T]
+	Add(values ...T)
+}
+
+// MarshalJSON encodes the values held by c as a JSON array. Any
+// Container[T] can implement JSONSerializer[T] by forwarding to it.
+func MarshalJSON[T any](c containers.Container[T]) ([]byte, error) {
+	return json.Marshal(c.Values())
+}
+
+// UnmarshalJSON decodes a JSON array produced by MarshalJSON and replaces
+// dst's contents with it. Any container whose Add/Clear methods make it
+// Appendable[T] can implement JSONDeserializer[T] by forwarding to it.
+func UnmarshalJSON[T any](data []byte, dst Appendable[T]) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	dst.Clear()
+	dst.Add(values...)
+
+	return nil
+}
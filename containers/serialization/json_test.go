@@ -0,0 +1,31 @@
+package serialization_test
+
+import (
+	"testing"
+
+	"github.com/Sergey-Polishchenko/go-data-structures/linkedlist/singly"
+)
+
+func TestToJSONFromJSON(t *testing.T) {
+	list := singly.New[int](1, 2, 3)
+
+	data, err := list.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON: unexpected error %v", err)
+	}
+
+	other := singly.New[int]()
+	if err := other.FromJSON(data); err != nil {
+		t.Fatalf("FromJSON: unexpected error %v", err)
+	}
+
+	got, want := other.Values(), list.Values()
+	if len(got) != len(want) {
+		t.Fatalf("FromJSON: got %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("FromJSON: got %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package containers
+
+import (
+	"cmp"
+	"slices"
+)
+
+// GetSortedValues returns the values of c sorted in ascending order.
+// The container itself is left untouched.
+// Time complexity: O(n log n).
+func GetSortedValues[T cmp.Ordered](c Container[T]) []T {
+	values := c.Values()
+	slices.Sort(values)
+	return values
+}
+
+// GetSortedValuesFunc returns the values of c sorted according to the
+// ordering defined by less. less must report a negative number when a
+// sorts before b, a positive number when a sorts after b, and zero when
+// they are equivalent, matching the contract of slices.SortFunc.
+// Time complexity: O(n log n).
+func GetSortedValuesFunc[T any](c Container[T], less func(a, b T) int) []T {
+	values := c.Values()
+	slices.SortFunc(values, less)
+	return values
+}
@@ -0,0 +1,44 @@
+package containers_test
+
+import (
+	"testing"
+
+	"github.com/Sergey-Polishchenko/go-data-structures/containers"
+	"github.com/Sergey-Polishchenko/go-data-structures/linkedlist/singly"
+)
+
+func TestGetSortedValues(t *testing.T) {
+	list := singly.New[int](3, 1, 2)
+
+	sorted := containers.GetSortedValues[int](list)
+	if !sliceEqual(sorted, []int{1, 2, 3}) {
+		t.Errorf("GetSortedValues: got %v, want %v", sorted, []int{1, 2, 3})
+	}
+
+	if !sliceEqual(list.Values(), []int{3, 1, 2}) {
+		t.Errorf("GetSortedValues mutated the container: got %v, want %v", list.Values(), []int{3, 1, 2})
+	}
+}
+
+func TestGetSortedValuesFunc(t *testing.T) {
+	list := singly.New[int](1, 3, 2)
+
+	sorted := containers.GetSortedValuesFunc[int](list, func(a, b int) int {
+		return b - a
+	})
+	if !sliceEqual(sorted, []int{3, 2, 1}) {
+		t.Errorf("GetSortedValuesFunc: got %v, want %v", sorted, []int{3, 2, 1})
+	}
+}
+
+func sliceEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,205 @@
+// Package heap implements a generic binary-heap priority queue with
+// container/heap-style ergonomics, without requiring callers to hand-roll
+// a heap.Interface implementation.
+//
+// Example:
+//
+//	pq := heap.New[int](func(a, b int) bool { return a < b })
+//	pq.Push(3)
+//	pq.Push(1)
+//	pq.Push(2)
+//	value, _ := pq.Pop() // Returns 1, nil
+package heap
+
+import (
+	"fmt"
+
+	"github.com/Sergey-Polishchenko/go-data-structures/containers"
+	"github.com/Sergey-Polishchenko/go-data-structures/errors"
+)
+
+// Assert PriorityQueue satisfies the shared container interface.
+var _ containers.Container[int] = (*PriorityQueue[int])(nil)
+
+// PriorityQueue is a binary-heap priority queue over generic values.
+// less defines the ordering: less(a, b) reports whether a has higher
+// priority than b, so the item at the top of the queue is always one for
+// which less returns true against every other item in the queue.
+//
+// The zero value is not ready to use; create one with New.
+type PriorityQueue[T any] struct {
+	items []*Item[T]
+	less  func(a, b T) bool
+}
+
+// New creates a new empty PriorityQueue ordered by less.
+// Time complexity: O(1).
+func New[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{less: less}
+}
+
+// Len returns the number of items in the queue.
+// Time complexity: O(1).
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.items)
+}
+
+// Size is an alias for Len, satisfying containers.Container[T].
+// Time complexity: O(1).
+func (pq *PriorityQueue[T]) Size() int {
+	return pq.Len()
+}
+
+// Empty reports whether the queue holds no items.
+// Time complexity: O(1).
+func (pq *PriorityQueue[T]) Empty() bool {
+	return pq.Len() == 0
+}
+
+// Clear removes all items from the queue.
+// Time complexity: O(1).
+func (pq *PriorityQueue[T]) Clear() {
+	pq.items = nil
+}
+
+// Values returns the items' values in heap order, which is not the same
+// as priority order except for the first element.
+// Time complexity: O(n).
+func (pq *PriorityQueue[T]) Values() []T {
+	values := make([]T, len(pq.items))
+	for i, item := range pq.items {
+		values[i] = item.value
+	}
+	return values
+}
+
+// String returns a human-readable representation of the queue.
+// Time complexity: O(n).
+func (pq *PriorityQueue[T]) String() string {
+	return fmt.Sprintf("%v", pq.Values())
+}
+
+// Push adds value to the queue and returns a stable handle to it that
+// can later be passed to Update or Remove.
+// Time complexity: O(log n).
+func (pq *PriorityQueue[T]) Push(value T) *Item[T] {
+	item := &Item[T]{value: value, index: len(pq.items)}
+	pq.items = append(pq.items, item)
+	pq.siftUp(item.index)
+	return item
+}
+
+// Peek returns the highest-priority value without removing it.
+// Returns ErrEmptyList if the queue is empty.
+// Time complexity: O(1).
+func (pq *PriorityQueue[T]) Peek() (T, error) {
+	if pq.Empty() {
+		var zero T
+		return zero, errors.ErrEmptyList
+	}
+	return pq.items[0].value, nil
+}
+
+// Pop removes and returns the highest-priority value.
+// Returns ErrEmptyList if the queue is empty.
+// Time complexity: O(log n).
+func (pq *PriorityQueue[T]) Pop() (T, error) {
+	if pq.Empty() {
+		var zero T
+		return zero, errors.ErrEmptyList
+	}
+
+	top := pq.items[0]
+	last := len(pq.items) - 1
+	pq.swap(0, last)
+	pq.items = pq.items[:last]
+	if len(pq.items) > 0 {
+		pq.siftDown(0)
+	}
+
+	return top.value, nil
+}
+
+// Update changes the value held at handle and restores the heap
+// property. handle must have been returned by Push on this queue.
+// Time complexity: O(log n).
+func (pq *PriorityQueue[T]) Update(handle *Item[T], value T) {
+	handle.value = value
+	pq.fix(handle.index)
+}
+
+// Remove removes the item at handle from the queue and returns its
+// value. handle must have been returned by Push on this queue.
+// Time complexity: O(log n).
+func (pq *PriorityQueue[T]) Remove(handle *Item[T]) T {
+	value := handle.value
+
+	idx := handle.index
+	last := len(pq.items) - 1
+	if idx != last {
+		pq.swap(idx, last)
+	}
+	pq.items = pq.items[:last]
+	if idx < len(pq.items) {
+		pq.fix(idx)
+	}
+
+	return value
+}
+
+// fix restores the heap property around index after its value changed,
+// whichever direction that requires.
+func (pq *PriorityQueue[T]) fix(index int) {
+	if !pq.siftDown(index) {
+		pq.siftUp(index)
+	}
+}
+
+// swap exchanges the items at i and j and keeps their stored indices in
+// sync, which is what lets Update and Remove find a handle in O(log n).
+func (pq *PriorityQueue[T]) swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.items[i].index = i
+	pq.items[j].index = j
+}
+
+// siftUp moves the item at index up while it has higher priority than
+// its parent.
+func (pq *PriorityQueue[T]) siftUp(index int) {
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !pq.less(pq.items[index].value, pq.items[parent].value) {
+			break
+		}
+		pq.swap(index, parent)
+		index = parent
+	}
+}
+
+// siftDown moves the item at index down while either child has higher
+// priority, and reports whether any swap was made.
+func (pq *PriorityQueue[T]) siftDown(index int) bool {
+	moved := false
+	n := len(pq.items)
+
+	for {
+		left, right := 2*index+1, 2*index+2
+		top := index
+
+		if left < n && pq.less(pq.items[left].value, pq.items[top].value) {
+			top = left
+		}
+		if right < n && pq.less(pq.items[right].value, pq.items[top].value) {
+			top = right
+		}
+		if top == index {
+			break
+		}
+
+		pq.swap(index, top)
+		index = top
+		moved = true
+	}
+
+	return moved
+}
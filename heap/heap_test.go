@@ -0,0 +1,97 @@
+package heap
+
+import (
+	"testing"
+
+	"github.com/Sergey-Polishchenko/go-data-structures/errors"
+)
+
+func less(a, b int) bool { return a < b }
+
+func TestPushPopOrder(t *testing.T) {
+	pq := New[int](less)
+	pq.Push(5)
+	pq.Push(1)
+	pq.Push(3)
+	pq.Push(2)
+	pq.Push(4)
+
+	if pq.Len() != 5 {
+		t.Fatalf("Len: got %d, want 5", pq.Len())
+	}
+
+	var got []int
+	for pq.Len() > 0 {
+		v, err := pq.Pop()
+		if err != nil {
+			t.Fatalf("Pop: unexpected error %v", err)
+		}
+		got = append(got, v)
+	}
+
+	want := []int{1, 2, 3, 4, 5}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Pop order: got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestPeek(t *testing.T) {
+	pq := New[int](less)
+
+	if _, err := pq.Peek(); err != errors.ErrEmptyList {
+		t.Errorf("Peek on empty queue: got %v, want %v", err, errors.ErrEmptyList)
+	}
+
+	pq.Push(2)
+	pq.Push(1)
+
+	v, err := pq.Peek()
+	if err != nil || v != 1 {
+		t.Errorf("Peek: got (%d, %v), want (1, nil)", v, err)
+	}
+	if pq.Len() != 2 {
+		t.Errorf("Peek should not remove: got Len %d, want 2", pq.Len())
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	pq := New[int](less)
+	pq.Push(10)
+	item := pq.Push(20)
+	pq.Push(30)
+
+	pq.Update(item, 1)
+
+	v, err := pq.Peek()
+	if err != nil || v != 1 {
+		t.Errorf("Update: got (%d, %v), want (1, nil)", v, err)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	pq := New[int](less)
+	pq.Push(10)
+	item := pq.Push(20)
+	pq.Push(30)
+
+	if got := pq.Remove(item); got != 20 {
+		t.Errorf("Remove: got %d, want 20", got)
+	}
+	if pq.Len() != 2 {
+		t.Errorf("Remove: got Len %d, want 2", pq.Len())
+	}
+
+	var got []int
+	for pq.Len() > 0 {
+		v, _ := pq.Pop()
+		got = append(got, v)
+	}
+	want := []int{10, 30}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("after Remove, Pop order: got %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package heap
+
+// Item is a stable handle to a value stored in a PriorityQueue, returned
+// by Push. Passing it back to Update or Remove locates the value in
+// O(log n) via the heap index item tracks and PriorityQueue maintains on
+// every swap.
+type Item[T any] struct {
+	value T
+	index int
+}
+
+// Value returns the item's current value.
+// Time complexity: O(1).
+func (item *Item[T]) Value() T {
+	return item.value
+}
@@ -0,0 +1,213 @@
+// Package concurrent provides a thread-safe wrapper around any
+// linkedlist.List[T] implementation, all of which are documented as not
+// safe for concurrent use on their own.
+package concurrent
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/Sergey-Polishchenko/go-data-structures/errors"
+	"github.com/Sergey-Polishchenko/go-data-structures/linkedlist"
+)
+
+// Assert List implementation for checkout List implementation
+var _ linkedlist.List[int] = (*List[int])(nil)
+
+// List wraps a linkedlist.List[T] with a sync.RWMutex so every method is
+// safe for concurrent use.
+//
+// In the default mode (New), every call, including reads, takes the
+// mutex. In snapshot mode (NewSnapshot), Values, Contains and Get read
+// from an immutable []T snapshot stored in an atomic.Pointer, refreshed
+// only when a mutating call occurs, so those readers take no lock at
+// all. That trades an O(n) refresh on every write for lock-free, linearly
+// scaling reads, which wins for read-heavy workloads.
+//
+// The Iterator returned by Iterator is a thin pass-through to the
+// wrapped list's iterator and is not itself synchronized; callers must
+// not use it concurrently with other operations on the same List.
+type List[T comparable] struct {
+	mu       sync.RWMutex
+	list     linkedlist.List[T]
+	snapshot atomic.Pointer[[]T] // nil unless snapshot mode is enabled
+}
+
+// New wraps list so every operation, including reads, takes list's lock.
+// Time complexity: O(1).
+func New[T comparable](list linkedlist.List[T]) *List[T] {
+	return &List[T]{list: list}
+}
+
+// NewSnapshot wraps list the same way as New, but additionally takes an
+// initial snapshot and keeps it refreshed after every mutation, enabling
+// the lock-free read path described on List.
+// Time complexity: O(n).
+func NewSnapshot[T comparable](list linkedlist.List[T]) *List[T] {
+	l := &List[T]{list: list}
+	l.refreshSnapshotLocked()
+	return l
+}
+
+// refreshSnapshotLocked stores a fresh snapshot of the wrapped list. The
+// caller must hold mu (for read or write) if the wrapped list could be
+// concurrently mutated; it is also safe to call before l is published.
+func (l *List[T]) refreshSnapshotLocked() {
+	values := l.list.Values()
+	l.snapshot.Store(&values)
+}
+
+// refreshSnapshotIfEnabledLocked refreshes the snapshot only if snapshot
+// mode was enabled via NewSnapshot. The caller must hold mu for writing.
+func (l *List[T]) refreshSnapshotIfEnabledLocked() {
+	if l.snapshot.Load() != nil {
+		l.refreshSnapshotLocked()
+	}
+}
+
+// AtomicUpdate runs fn with exclusive access to the wrapped list, so a
+// compound read-modify-write sequence over multiple calls to fn's
+// argument happens as a single atomic operation from the point of view
+// of other callers.
+// Time complexity: that of fn, plus O(n) to refresh the snapshot if
+// snapshot mode is enabled.
+func (l *List[T]) AtomicUpdate(fn func(linkedlist.List[T]) error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	err := fn(l.list)
+	l.refreshSnapshotIfEnabledLocked()
+
+	return err
+}
+
+// Add appends one or more values to the end of the list.
+func (l *List[T]) Add(values ...T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.list.Add(values...)
+	l.refreshSnapshotIfEnabledLocked()
+}
+
+// Get returns the value at the specified index.
+func (l *List[T]) Get(index int) (T, error) {
+	if snap := l.snapshot.Load(); snap != nil {
+		values := *snap
+		if index < 0 || index >= len(values) {
+			var zero T
+			return zero, errors.ErrIndexOutOfBounds
+		}
+		return values[index], nil
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.list.Get(index)
+}
+
+// Remove deletes the element at the specified index.
+func (l *List[T]) Remove(index int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	err := l.list.Remove(index)
+	l.refreshSnapshotIfEnabledLocked()
+
+	return err
+}
+
+// Contains checks if all specified values exist in the list.
+func (l *List[T]) Contains(values ...T) bool {
+	if snap := l.snapshot.Load(); snap != nil {
+		return containsAll(*snap, values)
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.list.Contains(values...)
+}
+
+// Swap swaps values of list nodes by their index.
+func (l *List[T]) Swap(index1, index2 int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	err := l.list.Swap(index1, index2)
+	l.refreshSnapshotIfEnabledLocked()
+
+	return err
+}
+
+// Insert adds one or more values at the specified index.
+func (l *List[T]) Insert(index int, values ...T) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	err := l.list.Insert(index, values...)
+	l.refreshSnapshotIfEnabledLocked()
+
+	return err
+}
+
+// Set updates the value at the specified index.
+func (l *List[T]) Set(index int, value T) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	err := l.list.Set(index, value)
+	l.refreshSnapshotIfEnabledLocked()
+
+	return err
+}
+
+// Values returns a fresh slice of all values in the list. In snapshot
+// mode this copies out of the current snapshot without taking any lock.
+func (l *List[T]) Values() []T {
+	if snap := l.snapshot.Load(); snap != nil {
+		return append([]T(nil), (*snap)...)
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.list.Values()
+}
+
+// Iterator returns an Iterator over the wrapped list. The returned
+// Iterator is not itself synchronized; see the List doc comment.
+func (l *List[T]) Iterator() linkedlist.Iterator[T] {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.list.Iterator()
+}
+
+// containsAll reports whether haystack contains every value in values,
+// mirroring the subset semantics of linkedlist.List.Contains.
+func containsAll[T comparable](haystack []T, values []T) bool {
+	if len(values) == 0 {
+		return true
+	}
+	if len(haystack) == 0 || len(haystack) < len(values) {
+		return false
+	}
+
+	remaining := make(map[T]bool, len(values))
+	for _, v := range values {
+		remaining[v] = true
+	}
+
+	for _, v := range haystack {
+		if remaining[v] {
+			delete(remaining, v)
+			if len(remaining) == 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,31 @@
+package concurrent
+
+import (
+	"testing"
+
+	"github.com/Sergey-Polishchenko/go-data-structures/linkedlist/singly"
+)
+
+// BenchmarkValues_RWMutex measures Values() throughput under concurrent
+// readers when every read takes the RWMutex.
+func BenchmarkValues_RWMutex(b *testing.B) {
+	list := New[int](singly.New[int](1, 2, 3, 4, 5))
+	benchmarkConcurrentReads(b, list)
+}
+
+// BenchmarkValues_Snapshot measures Values() throughput under concurrent
+// readers in snapshot mode, where reads never take a lock. It should
+// scale with GOMAXPROCS where the RWMutex version plateaus.
+func BenchmarkValues_Snapshot(b *testing.B) {
+	list := NewSnapshot[int](singly.New[int](1, 2, 3, 4, 5))
+	benchmarkConcurrentReads(b, list)
+}
+
+func benchmarkConcurrentReads(b *testing.B, list *List[int]) {
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = list.Values()
+		}
+	})
+}
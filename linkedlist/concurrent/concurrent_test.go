@@ -0,0 +1,87 @@
+package concurrent
+
+import (
+	"testing"
+
+	"github.com/Sergey-Polishchenko/go-data-structures/errors"
+	"github.com/Sergey-Polishchenko/go-data-structures/linkedlist"
+	"github.com/Sergey-Polishchenko/go-data-structures/linkedlist/singly"
+)
+
+func TestRWMutexMode(t *testing.T) {
+	list := New[int](singly.New[int](1, 2, 3))
+
+	list.Add(4)
+	if !sliceEqual(list.Values(), []int{1, 2, 3, 4}) {
+		t.Fatalf("Add: got %v, want %v", list.Values(), []int{1, 2, 3, 4})
+	}
+
+	v, err := list.Get(1)
+	if err != nil || v != 2 {
+		t.Errorf("Get: got (%d, %v), want (2, nil)", v, err)
+	}
+
+	if _, err := list.Get(99); err != errors.ErrIndexOutOfBounds {
+		t.Errorf("Get out of bounds: got %v, want %v", err, errors.ErrIndexOutOfBounds)
+	}
+
+	if !list.Contains(2, 3) {
+		t.Errorf("Contains: expected true")
+	}
+}
+
+func TestSnapshotMode(t *testing.T) {
+	list := NewSnapshot[int](singly.New[int](1, 2, 3))
+
+	if !sliceEqual(list.Values(), []int{1, 2, 3}) {
+		t.Fatalf("initial snapshot: got %v, want %v", list.Values(), []int{1, 2, 3})
+	}
+
+	list.Add(4)
+	if !sliceEqual(list.Values(), []int{1, 2, 3, 4}) {
+		t.Fatalf("snapshot after Add: got %v, want %v", list.Values(), []int{1, 2, 3, 4})
+	}
+
+	if err := list.Remove(0); err != nil {
+		t.Fatalf("Remove: unexpected error %v", err)
+	}
+	if !sliceEqual(list.Values(), []int{2, 3, 4}) {
+		t.Fatalf("snapshot after Remove: got %v, want %v", list.Values(), []int{2, 3, 4})
+	}
+
+	v, err := list.Get(0)
+	if err != nil || v != 2 {
+		t.Errorf("Get: got (%d, %v), want (2, nil)", v, err)
+	}
+
+	if !list.Contains(3, 4) {
+		t.Errorf("Contains: expected true")
+	}
+}
+
+func TestAtomicUpdate(t *testing.T) {
+	list := New[int](singly.New[int](1, 2, 3))
+
+	err := list.AtomicUpdate(func(l linkedlist.List[int]) error {
+		l.Add(4)
+		return l.Set(0, 10)
+	})
+	if err != nil {
+		t.Fatalf("AtomicUpdate: unexpected error %v", err)
+	}
+	if !sliceEqual(list.Values(), []int{10, 2, 3, 4}) {
+		t.Fatalf("AtomicUpdate: got %v, want %v", list.Values(), []int{10, 2, 3, 4})
+	}
+}
+
+func sliceEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
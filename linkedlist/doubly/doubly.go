@@ -0,0 +1,453 @@
+// Package doubly implements a doubly-linked list with an element-handle
+// API modeled on the standard library's container/list.
+//
+// List satisfies linkedlist.List[T] for index-based access, but index
+// operations remain O(n) just like in singly. What doubly adds on top
+// is a set of Element[T]-based operations (PushFront, PushBack,
+// InsertBefore, InsertAfter, MoveToFront, MoveToBack, MoveBefore,
+// MoveAfter and RemoveElement) that splice nodes in O(1), which an
+// index-only interface cannot express.
+//
+// RemoveElement is the handle-based remove; it is not named Remove
+// because linkedlist.List[T] already declares Remove(index int) error,
+// and container/list names its equivalent Remove(e) too.
+//
+// The list is not thread-safe.
+//
+// Example:
+//
+//	list := doubly.New[int]()
+//	e := list.PushBack(1)
+//	list.PushBack(2)
+//	list.MoveToFront(e)
+package doubly
+
+import (
+	"fmt"
+
+	"github.com/Sergey-Polishchenko/go-data-structures/containers"
+	"github.com/Sergey-Polishchenko/go-data-structures/containers/serialization"
+	"github.com/Sergey-Polishchenko/go-data-structures/errors"
+	"github.com/Sergey-Polishchenko/go-data-structures/linkedlist"
+)
+
+// Assert List implementation for checkout List implementation
+var _ linkedlist.List[int] = (*List[int])(nil)
+
+// Assert List satisfies the shared container and JSON (de)serialization interfaces.
+var (
+	_ containers.Container[int]           = (*List[int])(nil)
+	_ serialization.JSONSerializer[int]   = (*List[int])(nil)
+	_ serialization.JSONDeserializer[int] = (*List[int])(nil)
+)
+
+// List represents a doubly-linked list of generic comparable values.
+// It keeps a sentinel root element so the list forms a ring internally,
+// which keeps every splice operation free of nil-neighbour special cases.
+type List[T comparable] struct {
+	root Element[T]
+	size int
+}
+
+// New creates a new empty List. Optional initial values can be provided.
+// Time complexity: O(1) (or O(n) if values are provided).
+func New[T comparable](values ...T) *List[T] {
+	list := new(List[T]).init()
+	if len(values) > 0 {
+		list.Add(values...)
+	}
+	return list
+}
+
+// init resets list to an empty ring and returns list.
+func (list *List[T]) init() *List[T] {
+	list.root.next = &list.root
+	list.root.prev = &list.root
+	list.root.list = list
+	list.size = 0
+	return list
+}
+
+// lazyInit initializes the root sentinel on first use of a zero-value List.
+func (list *List[T]) lazyInit() {
+	if list.root.next == nil {
+		list.init()
+	}
+}
+
+// Size returns the number of elements in the list.
+// Time complexity: O(1).
+func (list *List[T]) Size() int {
+	return list.size
+}
+
+// IsEmpty checks if the list has no elements.
+// Time complexity: O(1).
+func (list *List[T]) IsEmpty() bool {
+	return list.size == 0
+}
+
+// Clear removes all elements from the list.
+// Time complexity: O(1).
+func (list *List[T]) Clear() {
+	list.init()
+}
+
+// Empty is an alias for IsEmpty, satisfying containers.Container[T].
+// Time complexity: O(1).
+func (list *List[T]) Empty() bool {
+	return list.IsEmpty()
+}
+
+// String returns a human-readable representation of the list.
+// Time complexity: O(n).
+func (list *List[T]) String() string {
+	return fmt.Sprintf("%v", list.Values())
+}
+
+// ToJSON encodes the list's values as a JSON array.
+// Time complexity: O(n).
+func (list *List[T]) ToJSON() ([]byte, error) {
+	return serialization.MarshalJSON[T](list)
+}
+
+// FromJSON replaces the list's contents with the values decoded from a
+// JSON array produced by ToJSON.
+// Time complexity: O(n).
+func (list *List[T]) FromJSON(data []byte) error {
+	return serialization.UnmarshalJSON[T](data, list)
+}
+
+// Front returns the first element of the list or nil if the list is empty.
+// Time complexity: O(1).
+func (list *List[T]) Front() *Element[T] {
+	if list.size == 0 {
+		return nil
+	}
+	return list.root.next
+}
+
+// Back returns the last element of the list or nil if the list is empty.
+// Time complexity: O(1).
+func (list *List[T]) Back() *Element[T] {
+	if list.size == 0 {
+		return nil
+	}
+	return list.root.prev
+}
+
+// First returns the head value.
+// Returns ErrEmptyList if called on an empty list.
+// Time complexity: O(1).
+func (list *List[T]) First() (T, error) {
+	if list.IsEmpty() {
+		var t T
+		return t, errors.ErrEmptyList
+	}
+	return list.root.next.Value, nil
+}
+
+// Last returns the tail value.
+// Returns ErrEmptyList if called on an empty list.
+// Time complexity: O(1).
+func (list *List[T]) Last() (T, error) {
+	if list.IsEmpty() {
+		var t T
+		return t, errors.ErrEmptyList
+	}
+	return list.root.prev.Value, nil
+}
+
+// insert inserts e after at, increments size and returns e.
+func (list *List[T]) insert(e, at *Element[T]) *Element[T] {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+	e.list = list
+	list.size += 1
+	return e
+}
+
+// insertValue wraps v in a new Element and inserts it after at.
+func (list *List[T]) insertValue(v T, at *Element[T]) *Element[T] {
+	return list.insert(&Element[T]{Value: v}, at)
+}
+
+// remove unlinks e from the list and decrements size.
+func (list *List[T]) remove(e *Element[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next = nil
+	e.prev = nil
+	e.list = nil
+	list.size -= 1
+}
+
+// move relocates e to sit immediately after at.
+func (list *List[T]) move(e, at *Element[T]) {
+	if e == at {
+		return
+	}
+	e.prev.next = e.next
+	e.next.prev = e.prev
+
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+// PushFront inserts a new element with value v at the front of the list
+// and returns its handle.
+// Time complexity: O(1).
+func (list *List[T]) PushFront(v T) *Element[T] {
+	list.lazyInit()
+	return list.insertValue(v, &list.root)
+}
+
+// PushBack inserts a new element with value v at the back of the list
+// and returns its handle.
+// Time complexity: O(1).
+func (list *List[T]) PushBack(v T) *Element[T] {
+	list.lazyInit()
+	return list.insertValue(v, list.root.prev)
+}
+
+// InsertBefore inserts a new element with value v immediately before mark
+// and returns its handle. mark must be an element of list, otherwise the
+// list is not modified and nil is returned.
+// Time complexity: O(1).
+func (list *List[T]) InsertBefore(v T, mark *Element[T]) *Element[T] {
+	if mark.list != list {
+		return nil
+	}
+	return list.insertValue(v, mark.prev)
+}
+
+// InsertAfter inserts a new element with value v immediately after mark
+// and returns its handle. mark must be an element of list, otherwise the
+// list is not modified and nil is returned.
+// Time complexity: O(1).
+func (list *List[T]) InsertAfter(v T, mark *Element[T]) *Element[T] {
+	if mark.list != list {
+		return nil
+	}
+	return list.insertValue(v, mark)
+}
+
+// MoveToFront moves element e to the front of list. e must be an element
+// of list, otherwise the list is not modified.
+// Time complexity: O(1).
+func (list *List[T]) MoveToFront(e *Element[T]) {
+	if e.list != list || list.root.next == e {
+		return
+	}
+	list.move(e, &list.root)
+}
+
+// MoveToBack moves element e to the back of list. e must be an element
+// of list, otherwise the list is not modified.
+// Time complexity: O(1).
+func (list *List[T]) MoveToBack(e *Element[T]) {
+	if e.list != list || list.root.prev == e {
+		return
+	}
+	list.move(e, list.root.prev)
+}
+
+// MoveBefore moves element e to its new position immediately before mark.
+// e and mark must be elements of list and must be distinct, otherwise the
+// list is not modified.
+// Time complexity: O(1).
+func (list *List[T]) MoveBefore(e, mark *Element[T]) {
+	if e.list != list || e == mark || mark.list != list {
+		return
+	}
+	list.move(e, mark.prev)
+}
+
+// MoveAfter moves element e to its new position immediately after mark.
+// e and mark must be elements of list and must be distinct, otherwise the
+// list is not modified.
+// Time complexity: O(1).
+func (list *List[T]) MoveAfter(e, mark *Element[T]) {
+	if e.list != list || e == mark || mark.list != list {
+		return
+	}
+	list.move(e, mark)
+}
+
+// RemoveElement removes e from the list and returns its value. e must be
+// an element of list, otherwise the list is not modified.
+// Time complexity: O(1).
+func (list *List[T]) RemoveElement(e *Element[T]) T {
+	if e.list == list {
+		list.remove(e)
+	}
+	return e.Value
+}
+
+// elementAt returns the element at the given index.
+// The caller must ensure index is in bounds.
+func (list *List[T]) elementAt(index int) *Element[T] {
+	e := list.root.next
+	for i := 0; i != index; i, e = i+1, e.next {
+	}
+	return e
+}
+
+// Add appends one or more values to the end of the list.
+// Time complexity: O(n) where n is the number of values added.
+func (list *List[T]) Add(values ...T) {
+	list.lazyInit()
+	for _, v := range values {
+		list.PushBack(v)
+	}
+}
+
+// Get returns the value at the specified index.
+// Returns error if index is out of bounds [0, size).
+// Time complexity: O(n).
+func (list *List[T]) Get(index int) (T, error) {
+	if !list.inBounds(index) {
+		var t T
+		return t, errors.ErrIndexOutOfBounds
+	}
+	return list.elementAt(index).Value, nil
+}
+
+// Values returns a slice of all values in the list.
+// Time complexity: O(n).
+func (list *List[T]) Values() []T {
+	values := make([]T, list.size)
+	for i, e := 0, list.root.next; i != list.size; i, e = i+1, e.next {
+		values[i] = e.Value
+	}
+	return values
+}
+
+// IndexOf returns the first index of the specified value.
+// Returns -1 and ErrElementNotFound if value not found.
+// Returns ErrEmptyList if called on an empty list.
+// Time complexity: O(n).
+func (list *List[T]) IndexOf(value T) (int, error) {
+	if list.IsEmpty() {
+		return -1, errors.ErrEmptyList
+	}
+
+	index := 0
+	for e := list.root.next; e != &list.root; e = e.next {
+		if e.Value == value {
+			return index, nil
+		}
+		index += 1
+	}
+
+	return -1, errors.ErrElementNotFound
+}
+
+// Remove deletes the element at the specified index.
+// Returns ErrIndexOutOfBounds if index is invalid.
+// Returns ErrEmptyList if called on an empty list.
+// Time complexity: O(n).
+func (list *List[T]) Remove(index int) error {
+	if list.IsEmpty() {
+		return errors.ErrEmptyList
+	}
+	if !list.inBounds(index) {
+		return errors.ErrIndexOutOfBounds
+	}
+
+	list.remove(list.elementAt(index))
+
+	return nil
+}
+
+// Contains checks if all specified values exist in the list.
+// Returns true if all values are present, false otherwise.
+// If no values are provided, returns true (empty set is always a subset).
+// If the list is empty and values are provided, returns false.
+// Time complexity: O(n + m) where n is list size and m is the number of values.
+func (list *List[T]) Contains(values ...T) bool {
+	if len(values) == 0 {
+		return true
+	}
+	if list.size == 0 || list.size < len(values) {
+		return false
+	}
+
+	valuesToFind := make(map[T]bool)
+	for _, value := range values {
+		valuesToFind[value] = true
+	}
+
+	for e := list.root.next; e != &list.root; e = e.next {
+		if valuesToFind[e.Value] {
+			delete(valuesToFind, e.Value)
+			if len(valuesToFind) == 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Swap swaps values of list nodes by their index.
+// Returns ErrIndexOutOfBounds if index is out of range [0, size).
+// Time complexity: O(n).
+func (list *List[T]) Swap(i, j int) error {
+	if !list.inBounds(i) || !list.inBounds(j) {
+		return errors.ErrIndexOutOfBounds
+	}
+
+	if i == j {
+		return nil
+	}
+
+	e1, e2 := list.elementAt(i), list.elementAt(j)
+	e1.Value, e2.Value = e2.Value, e1.Value
+
+	return nil
+}
+
+// Insert adds one or more values at the specified index.
+// If index is 0, the values are prepended to the list.
+// If index equals the list size, the values are appended.
+// Returns ErrIndexOutOfBounds if index is out of range [0, size].
+// Time complexity: O(n).
+func (list *List[T]) Insert(index int, values ...T) error {
+	if !list.inBounds(index) {
+		if index == list.size {
+			list.Add(values...)
+			return nil
+		}
+		return errors.ErrIndexOutOfBounds
+	}
+
+	at := list.elementAt(index).prev
+	for _, v := range values {
+		at = list.insertValue(v, at)
+	}
+
+	return nil
+}
+
+// Set updates the value at the specified index.
+// Returns ErrIndexOutOfBounds if index is invalid.
+// Time complexity: O(n).
+func (list *List[T]) Set(index int, value T) error {
+	if !list.inBounds(index) {
+		return errors.ErrIndexOutOfBounds
+	}
+
+	list.elementAt(index).Value = value
+
+	return nil
+}
+
+// Check if index is in bounds [0, size).
+func (list *List[T]) inBounds(index int) bool {
+	return index >= 0 && index < list.size
+}
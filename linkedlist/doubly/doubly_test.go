@@ -0,0 +1,263 @@
+package doubly
+
+import (
+	"testing"
+
+	"github.com/Sergey-Polishchenko/go-data-structures/errors"
+)
+
+type listTest struct {
+	name        string
+	operation   func(*List[int]) (interface{}, error)
+	expected    interface{}
+	expectedErr error
+}
+
+var listTests = []listTest{
+	// Add Test
+	{
+		name: "Add to empty list",
+		operation: func(l *List[int]) (interface{}, error) {
+			l.Add(1)
+			return l.Values(), nil
+		},
+		expected:    []int{1},
+		expectedErr: nil,
+	},
+	{
+		name: "Add multiple elements",
+		operation: func(l *List[int]) (interface{}, error) {
+			l.Add(1, 2, 3)
+			return l.Values(), nil
+		},
+		expected:    []int{1, 2, 3},
+		expectedErr: nil,
+	},
+
+	// Get Test
+	{
+		name: "Get from empty list",
+		operation: func(l *List[int]) (interface{}, error) {
+			return l.Get(0)
+		},
+		expected:    0,
+		expectedErr: errors.ErrIndexOutOfBounds,
+	},
+	{
+		name: "Get valid index",
+		operation: func(l *List[int]) (interface{}, error) {
+			l.Add(1, 2, 3)
+			return l.Get(1)
+		},
+		expected:    2,
+		expectedErr: nil,
+	},
+
+	// Remove Test
+	{
+		name: "Remove from empty list",
+		operation: func(l *List[int]) (interface{}, error) {
+			return nil, l.Remove(0)
+		},
+		expected:    nil,
+		expectedErr: errors.ErrEmptyList,
+	},
+	{
+		name: "Remove first element",
+		operation: func(l *List[int]) (interface{}, error) {
+			l.Add(1, 2, 3)
+			return nil, l.Remove(0)
+		},
+		expected:    []int{2, 3},
+		expectedErr: nil,
+	},
+
+	// Contains Test
+	{
+		name: "Contains all elements",
+		operation: func(l *List[int]) (interface{}, error) {
+			l.Add(1, 2, 3)
+			return l.Contains(1, 2), nil
+		},
+		expected:    true,
+		expectedErr: nil,
+	},
+}
+
+func TestList(t *testing.T) {
+	for _, tt := range listTests {
+		t.Run(
+			tt.name,
+			func(t *testing.T) {
+				list := New[int]()
+				result, err := tt.operation(list)
+
+				if err != tt.expectedErr {
+					t.Errorf("Error: got %v, want %v", err, tt.expectedErr)
+				}
+
+				switch v := result.(type) {
+				case []int:
+					if !sliceEqual(v, tt.expected.([]int)) {
+						t.Errorf("Values: got %v, want %v", v, tt.expected)
+					}
+				case int:
+					if v != tt.expected.(int) {
+						t.Errorf("Value: got %d, want %d", v, tt.expected)
+					}
+				case bool:
+					if v != tt.expected.(bool) {
+						t.Errorf("Bool: got %t, want %t", v, tt.expected)
+					}
+				}
+
+				if tt.expectedErr == nil && tt.expected != nil {
+					if values, ok := tt.expected.([]int); ok {
+						if !sliceEqual(list.Values(), values) {
+							t.Errorf("After operation: got %v, want %v", list.Values(), values)
+						}
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestElementHandles(t *testing.T) {
+	list := New[int]()
+	e1 := list.PushBack(1)
+	e2 := list.PushBack(2)
+	e3 := list.PushBack(3)
+
+	if !sliceEqual(list.Values(), []int{1, 2, 3}) {
+		t.Fatalf("PushBack: got %v, want %v", list.Values(), []int{1, 2, 3})
+	}
+
+	if e1.Next() != e2 || e2.Next() != e3 || e3.Next() != nil {
+		t.Errorf("Next() chain is broken")
+	}
+	if e3.Prev() != e2 || e2.Prev() != e1 || e1.Prev() != nil {
+		t.Errorf("Prev() chain is broken")
+	}
+
+	e0 := list.PushFront(0)
+	if !sliceEqual(list.Values(), []int{0, 1, 2, 3}) {
+		t.Fatalf("PushFront: got %v, want %v", list.Values(), []int{0, 1, 2, 3})
+	}
+
+	list.InsertAfter(5, e0)
+	list.InsertBefore(9, e1)
+	if !sliceEqual(list.Values(), []int{0, 5, 9, 1, 2, 3}) {
+		t.Fatalf("InsertAfter/InsertBefore: got %v, want %v", list.Values(), []int{0, 5, 9, 1, 2, 3})
+	}
+
+	list.MoveToFront(e3)
+	if !sliceEqual(list.Values(), []int{3, 0, 5, 9, 1, 2}) {
+		t.Fatalf("MoveToFront: got %v, want %v", list.Values(), []int{3, 0, 5, 9, 1, 2})
+	}
+
+	list.MoveToBack(e3)
+	if !sliceEqual(list.Values(), []int{0, 5, 9, 1, 2, 3}) {
+		t.Fatalf("MoveToBack: got %v, want %v", list.Values(), []int{0, 5, 9, 1, 2, 3})
+	}
+
+	list.MoveAfter(e0, e2)
+	if !sliceEqual(list.Values(), []int{5, 9, 1, 2, 0, 3}) {
+		t.Fatalf("MoveAfter: got %v, want %v", list.Values(), []int{5, 9, 1, 2, 0, 3})
+	}
+
+	if got := list.RemoveElement(e1); got != 1 {
+		t.Errorf("RemoveElement: got %d, want 1", got)
+	}
+	if !sliceEqual(list.Values(), []int{5, 9, 2, 0, 3}) {
+		t.Fatalf("after RemoveElement: got %v, want %v", list.Values(), []int{5, 9, 2, 0, 3})
+	}
+}
+
+func sliceEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestIterator(t *testing.T) {
+	list := New[int](1, 2, 3)
+
+	it := list.Iterator()
+
+	var got []int
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+	if !sliceEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Next: got %v, want %v", got, []int{1, 2, 3})
+	}
+
+	var back []int
+	for it.Prev() {
+		back = append(back, it.Value())
+	}
+	if !sliceEqual(back, []int{2, 1}) {
+		t.Errorf("Prev: got %v, want %v", back, []int{2, 1})
+	}
+
+	it.Begin()
+	it.Next()
+	it.Set(9)
+	if v, _ := list.Get(0); v != 9 {
+		t.Errorf("Set: got %d, want 9", v)
+	}
+
+	it.Begin()
+	it.Next() // 9
+	it.Next() // 2
+	it.Remove()
+	if !sliceEqual(list.Values(), []int{9, 3}) {
+		t.Errorf("Remove: got %v, want %v", list.Values(), []int{9, 3})
+	}
+	if it.Value() != 3 {
+		t.Errorf("Remove: iterator should land on the next element, got %d", it.Value())
+	}
+}
+
+func TestReverseIterator(t *testing.T) {
+	list := New[int](1, 2, 3)
+
+	it := list.ReverseIterator()
+
+	var got []int
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+	if !sliceEqual(got, []int{3, 2, 1}) {
+		t.Errorf("ReverseIterator Next: got %v, want %v", got, []int{3, 2, 1})
+	}
+}
+
+func TestReverseIteratorRemove(t *testing.T) {
+	list := New[int](10, 20, 30)
+
+	it := list.ReverseIterator()
+	it.Next() // 30
+	it.Next() // 20
+	if it.Index() != 1 {
+		t.Fatalf("Index before Remove: got %d, want 1", it.Index())
+	}
+
+	it.Remove()
+	if !sliceEqual(list.Values(), []int{10, 30}) {
+		t.Errorf("Remove: got %v, want %v", list.Values(), []int{10, 30})
+	}
+	if it.Value() != 10 {
+		t.Errorf("Remove: iterator should land on 10, got %d", it.Value())
+	}
+	if it.Index() != 0 {
+		t.Errorf("Index after Remove: got %d, want 0", it.Index())
+	}
+}
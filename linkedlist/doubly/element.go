@@ -0,0 +1,31 @@
+package doubly
+
+// Element is a handle to a node stored in a List, modeled on the standard
+// library's container/list.Element. It is returned by the insertion
+// methods of List and can be passed back in to splice or move the node
+// it refers to in O(1).
+type Element[T comparable] struct {
+	// Value is the value stored with this element.
+	Value T
+
+	next, prev *Element[T]
+	list       *List[T]
+}
+
+// Next returns the next list element or nil if e is the last element.
+// Time complexity: O(1).
+func (e *Element[T]) Next() *Element[T] {
+	if p := e.next; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+
+// Prev returns the previous list element or nil if e is the first element.
+// Time complexity: O(1).
+func (e *Element[T]) Prev() *Element[T] {
+	if p := e.prev; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
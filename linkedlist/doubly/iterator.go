@@ -0,0 +1,181 @@
+package doubly
+
+import "github.com/Sergey-Polishchenko/go-data-structures/linkedlist"
+
+// iterator implements linkedlist.Iterator[T] over a doubly-linked List.
+// When reverse is true it walks back-to-front: Next() moves toward the
+// front and Prev() moves toward the back.
+type iterator[T comparable] struct {
+	list    *List[T]
+	current *Element[T]
+	index   int
+	reverse bool
+
+	// pendingNext is set by Remove to make the following Next (or Prev)
+	// call land on the replacement element instead of skipping past it.
+	pendingNext bool
+}
+
+// Assert iterator implementation for checkout Iterator implementation
+var _ linkedlist.Iterator[int] = (*iterator[int])(nil)
+
+// Iterator returns a new Iterator positioned before the first element,
+// walking front-to-back.
+// Time complexity: O(1).
+func (list *List[T]) Iterator() linkedlist.Iterator[T] {
+	list.lazyInit()
+	it := &iterator[T]{list: list}
+	it.Begin()
+	return it
+}
+
+// ReverseIterator returns a new Iterator positioned before the last
+// element, walking back-to-front: Next() moves toward the front and
+// Prev() moves toward the back.
+// Time complexity: O(1).
+func (list *List[T]) ReverseIterator() linkedlist.Iterator[T] {
+	list.lazyInit()
+	it := &iterator[T]{list: list, reverse: true}
+	it.Begin()
+	return it
+}
+
+// Begin repositions the iterator before the first element in its
+// direction of travel.
+// Time complexity: O(1).
+func (it *iterator[T]) Begin() {
+	it.current = &it.list.root
+	it.pendingNext = false
+	if it.reverse {
+		it.index = it.list.size
+	} else {
+		it.index = -1
+	}
+}
+
+// End repositions the iterator after the last element in its direction
+// of travel.
+// Time complexity: O(1).
+func (it *iterator[T]) End() {
+	it.current = &it.list.root
+	it.pendingNext = false
+	if it.reverse {
+		it.index = -1
+	} else {
+		it.index = it.list.size
+	}
+}
+
+// Next advances the iterator to the next element and reports whether
+// there was one.
+// Time complexity: O(1).
+func (it *iterator[T]) Next() bool {
+	if it.pendingNext {
+		it.pendingNext = false
+		return it.current != &it.list.root
+	}
+
+	// At the root sentinel, only the start position of the direction of
+	// travel may step onto an element; past the end the iterator stays
+	// exhausted instead of wrapping round to the other end.
+	if it.current == &it.list.root && (it.index == -1) == it.reverse {
+		return false
+	}
+
+	n := it.current.next
+	if it.reverse {
+		n = it.current.prev
+	}
+
+	if n == &it.list.root {
+		return false
+	}
+
+	it.current = n
+	if it.reverse {
+		it.index -= 1
+	} else {
+		it.index += 1
+	}
+
+	return true
+}
+
+// Prev moves the iterator to the previous element and reports whether
+// there was one.
+// Time complexity: O(1).
+func (it *iterator[T]) Prev() bool {
+	if it.pendingNext {
+		it.pendingNext = false
+		return it.current != &it.list.root
+	}
+
+	// At the root sentinel, only the end position of the direction of
+	// travel may step back onto an element.
+	if it.current == &it.list.root && (it.index == -1) != it.reverse {
+		return false
+	}
+
+	p := it.current.prev
+	if it.reverse {
+		p = it.current.next
+	}
+
+	if p == &it.list.root {
+		return false
+	}
+
+	it.current = p
+	if it.reverse {
+		it.index += 1
+	} else {
+		it.index -= 1
+	}
+
+	return true
+}
+
+// Index returns the index of the current element.
+// Time complexity: O(1).
+func (it *iterator[T]) Index() int {
+	return it.index
+}
+
+// Value returns the value of the current element.
+// Time complexity: O(1).
+func (it *iterator[T]) Value() T {
+	return it.current.Value
+}
+
+// Set updates the value of the current element.
+// Time complexity: O(1).
+func (it *iterator[T]) Set(v T) {
+	if it.current == &it.list.root {
+		return
+	}
+	it.current.Value = v
+}
+
+// Remove removes the current element from the underlying list. The
+// element that takes its place becomes current, and pendingNext marks it
+// as not yet visited, so the following Next (or Prev) lands on it
+// instead of skipping past it.
+// Time complexity: O(1).
+func (it *iterator[T]) Remove() {
+	if it.current == &it.list.root {
+		return
+	}
+
+	e := it.current
+	n := e.next
+	if it.reverse {
+		n = e.prev
+	}
+
+	it.list.remove(e)
+	it.current = n
+	it.pendingNext = true
+	if it.reverse {
+		it.index -= 1
+	}
+}
@@ -0,0 +1,42 @@
+package linkedlist
+
+// Iterator provides stateful, position-based traversal over a List. It
+// lets callers walk, inspect and mutate elements one step at a time in
+// O(1) per step, instead of paying the O(n) per-call cost of repeatedly
+// indexing with Get(i) in a loop.
+//
+// An Iterator starts in the "before the first element" position. Next
+// and Prev move the cursor and report whether there was an element to
+// move onto; Value, Set and Remove operate on the element the cursor
+// currently sits on and are only valid to call after Next or Prev has
+// returned true.
+type Iterator[T any] interface {
+	// Next advances the iterator to the next element and reports whether
+	// there was one.
+	Next() bool
+	// Prev moves the iterator to the previous element and reports
+	// whether there was one.
+	Prev() bool
+	// Index returns the index of the current element.
+	Index() int
+	// Value returns the value of the current element.
+	Value() T
+	// Begin repositions the iterator before the first element, so the
+	// next call to Next() moves onto it.
+	Begin()
+	// End repositions the iterator after the last element, so the next
+	// call to Prev() moves onto it.
+	End()
+	// Remove removes the current element from the underlying list. The
+	// element that takes its place becomes current, but the iterator does
+	// not consider it "visited" yet: the next call to Next (or Prev) lands
+	// on it rather than skipping past it, so the usual
+	// for it.Next() { ...; it.Remove() } idiom visits every element
+	// exactly once. If the removed element had no successor in the
+	// direction of travel, the iterator becomes exhausted in that
+	// direction: further moves that way return false until Begin or End
+	// repositions it.
+	Remove()
+	// Set updates the value of the current element.
+	Set(v T)
+}
@@ -8,4 +8,6 @@ type List[T comparable] interface {
 	Swap(index1, index2 int) error
 	Insert(index int, values ...T) error
 	Set(index int, value T) error
+	Values() []T
+	Iterator() Iterator[T]
 }
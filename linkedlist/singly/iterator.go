@@ -0,0 +1,132 @@
+package singly
+
+import "github.com/Sergey-Polishchenko/go-data-structures/linkedlist"
+
+// iterator implements linkedlist.Iterator[T] over a singly-linked List.
+//
+// Because nodes in a singly-linked list only point forward, Prev cannot
+// be implemented in O(1) and always returns false; use doubly.List if
+// backward iteration is required.
+type iterator[T comparable] struct {
+	list    *List[T]
+	prev    *node[T]
+	current *node[T]
+	index   int
+
+	// pendingNext is set by Remove to make the next Next() call land on
+	// the replacement element instead of skipping past it.
+	pendingNext bool
+}
+
+// Assert iterator implementation for checkout Iterator implementation
+var _ linkedlist.Iterator[int] = (*iterator[int])(nil)
+
+// Iterator returns a new Iterator positioned before the first element.
+// Time complexity: O(1).
+func (list *List[T]) Iterator() linkedlist.Iterator[T] {
+	it := &iterator[T]{list: list}
+	it.Begin()
+	return it
+}
+
+// Begin repositions the iterator before the first element.
+// Time complexity: O(1).
+func (it *iterator[T]) Begin() {
+	it.prev = nil
+	it.current = nil
+	it.index = -1
+	it.pendingNext = false
+}
+
+// End repositions the iterator after the last element, so the next call
+// to Next() reports false: a singly-linked iterator can't step backward
+// onto it, so there's nothing valid for Value()/Index() to return there.
+// Time complexity: O(1).
+func (it *iterator[T]) End() {
+	it.prev = it.list.last
+	it.current = nil
+	it.index = it.list.size
+	it.pendingNext = false
+}
+
+// Next advances the iterator to the next element and reports whether
+// there was one.
+// Time complexity: O(1).
+func (it *iterator[T]) Next() bool {
+	if it.pendingNext {
+		it.pendingNext = false
+		return it.current != nil
+	}
+
+	if it.current == nil {
+		if it.index != -1 {
+			return false
+		}
+		if it.list.first == nil {
+			return false
+		}
+		it.current = it.list.first
+		it.index = 0
+		return true
+	}
+
+	if it.current.next == nil {
+		return false
+	}
+
+	it.prev = it.current
+	it.current = it.current.next
+	it.index += 1
+
+	return true
+}
+
+// Prev is unsupported on a singly-linked list and always returns false.
+// Time complexity: O(1).
+func (it *iterator[T]) Prev() bool {
+	return false
+}
+
+// Index returns the index of the current element.
+// Time complexity: O(1).
+func (it *iterator[T]) Index() int {
+	return it.index
+}
+
+// Value returns the value of the current element.
+// Time complexity: O(1).
+func (it *iterator[T]) Value() T {
+	return it.current.value
+}
+
+// Set updates the value of the current element.
+// Time complexity: O(1).
+func (it *iterator[T]) Set(v T) {
+	it.current.value = v
+}
+
+// Remove removes the current element from the underlying list. The
+// element that takes its place becomes current, and pendingNext marks it
+// as not yet visited, so the following Next() lands on it instead of
+// skipping past it.
+// Time complexity: O(1).
+func (it *iterator[T]) Remove() {
+	if it.current == nil {
+		return
+	}
+
+	next := it.current.next
+
+	if it.prev == nil {
+		it.list.first = next
+	} else {
+		it.prev.next = next
+	}
+	if it.current == it.list.last {
+		it.list.last = it.prev
+	}
+	it.list.size -= 1
+
+	it.current = next
+	it.pendingNext = true
+}
@@ -12,6 +12,10 @@
 package singly
 
 import (
+	"fmt"
+
+	"github.com/Sergey-Polishchenko/go-data-structures/containers"
+	"github.com/Sergey-Polishchenko/go-data-structures/containers/serialization"
 	"github.com/Sergey-Polishchenko/go-data-structures/errors"
 	"github.com/Sergey-Polishchenko/go-data-structures/linkedlist"
 )
@@ -19,6 +23,13 @@ import (
 // Assert List implementation for checkout List implementation
 var _ linkedlist.List[int] = (*List[int])(nil)
 
+// Assert List satisfies the shared container and JSON (de)serialization interfaces.
+var (
+	_ containers.Container[int]           = (*List[int])(nil)
+	_ serialization.JSONSerializer[int]   = (*List[int])(nil)
+	_ serialization.JSONDeserializer[int] = (*List[int])(nil)
+)
+
 // List represents a singly-linked list.
 // It maintains references to the first and last nodes, and the total size.
 // T is a comparable type constraint.
@@ -153,6 +164,31 @@ func (list *List[T]) IsEmpty() bool {
 	return list.size == 0
 }
 
+// Empty is an alias for IsEmpty, satisfying containers.Container[T].
+// Time complexity: O(1).
+func (list *List[T]) Empty() bool {
+	return list.IsEmpty()
+}
+
+// String returns a human-readable representation of the list.
+// Time complexity: O(n).
+func (list *List[T]) String() string {
+	return fmt.Sprintf("%v", list.Values())
+}
+
+// ToJSON encodes the list's values as a JSON array.
+// Time complexity: O(n).
+func (list *List[T]) ToJSON() ([]byte, error) {
+	return serialization.MarshalJSON[T](list)
+}
+
+// FromJSON replaces the list's contents with the values decoded from a
+// JSON array produced by ToJSON.
+// Time complexity: O(n).
+func (list *List[T]) FromJSON(data []byte) error {
+	return serialization.UnmarshalJSON[T](data, list)
+}
+
 // Clear removes all elements from the list.
 // Time complexity: O(1).
 func (list *List[T]) Clear() {
@@ -306,6 +342,34 @@ func (list *List[T]) Set(index int, value T) error {
 	return nil
 }
 
+// Each calls fn once for every element in the list, in order, passing
+// its index and value.
+// Time complexity: O(n).
+func (list *List[T]) Each(fn func(index int, value T)) {
+	index := 0
+	for node := list.first; node != nil; node = node.next {
+		fn(index, node.value)
+		index += 1
+	}
+}
+
+// Find returns the index and value of the first element for which fn
+// returns true, along with true. If no element satisfies fn, it returns
+// -1, the zero value of T, and false.
+// Time complexity: O(n).
+func (list *List[T]) Find(fn func(T) bool) (int, T, bool) {
+	index := 0
+	for node := list.first; node != nil; node = node.next {
+		if fn(node.value) {
+			return index, node.value, true
+		}
+		index += 1
+	}
+
+	var zero T
+	return -1, zero, false
+}
+
 // Check if index is in bounds [0, size).
 func (list *List[T]) inBounds(index int) bool {
 	return index >= 0 && index < list.size
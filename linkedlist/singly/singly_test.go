@@ -145,3 +145,65 @@ func sliceEqual(a, b []int) bool {
 	}
 	return true
 }
+
+func TestIterator(t *testing.T) {
+	list := New[int](1, 2, 3)
+
+	it := list.Iterator()
+
+	var got []int
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+	if !sliceEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Next: got %v, want %v", got, []int{1, 2, 3})
+	}
+	if it.Next() {
+		t.Errorf("Next: expected false past the last element")
+	}
+	if it.Prev() {
+		t.Errorf("Prev: singly iterator must always report false")
+	}
+
+	it.Begin()
+	if !it.Next() || it.Value() != 1 {
+		t.Fatalf("Begin/Next: expected to land on the first element")
+	}
+	it.Set(9)
+	if v, _ := list.Get(0); v != 9 {
+		t.Errorf("Set: got %d, want 9", v)
+	}
+
+	it.Begin()
+	it.Next() // 9
+	it.Next() // 2
+	it.Remove()
+	if !sliceEqual(list.Values(), []int{9, 3}) {
+		t.Errorf("Remove: got %v, want %v", list.Values(), []int{9, 3})
+	}
+	if it.Value() != 3 {
+		t.Errorf("Remove: iterator should land on the next element, got %d", it.Value())
+	}
+}
+
+func TestEachAndFind(t *testing.T) {
+	list := New[int](10, 20, 30)
+
+	var indexes, values []int
+	list.Each(func(index int, value int) {
+		indexes = append(indexes, index)
+		values = append(values, value)
+	})
+	if !sliceEqual(indexes, []int{0, 1, 2}) || !sliceEqual(values, []int{10, 20, 30}) {
+		t.Errorf("Each: got indexes %v values %v", indexes, values)
+	}
+
+	index, value, ok := list.Find(func(v int) bool { return v == 20 })
+	if !ok || index != 1 || value != 20 {
+		t.Errorf("Find: got (%d, %d, %t), want (1, 20, true)", index, value, ok)
+	}
+
+	if _, _, ok := list.Find(func(v int) bool { return v == 99 }); ok {
+		t.Errorf("Find: expected false for a missing value")
+	}
+}
@@ -0,0 +1,195 @@
+package ring
+
+import "github.com/Sergey-Polishchenko/go-data-structures/linkedlist"
+
+// iterator implements linkedlist.Iterator[T] over a Ring, walking
+// forward from index 0 through Size()-1 without wrapping past a single
+// lap.
+type iterator[T comparable] struct {
+	ring    *Ring[T]
+	current *node[T]
+	index   int
+
+	// pendingNext is set by Remove to make the following Next (or Prev)
+	// call land on the replacement element instead of skipping past it.
+	pendingNext bool
+
+	// backward records whether the iterator last moved via Prev rather
+	// than Next, so Remove knows which neighbor is "next" in the
+	// direction of travel.
+	backward bool
+}
+
+// Assert iterator implementation for checkout Iterator implementation
+var _ linkedlist.Iterator[int] = (*iterator[int])(nil)
+
+// Iterator returns a new Iterator positioned before the first element.
+// Time complexity: O(1).
+func (r *Ring[T]) Iterator() linkedlist.Iterator[T] {
+	it := &iterator[T]{ring: r}
+	it.Begin()
+	return it
+}
+
+// Begin repositions the iterator before the first element.
+// Time complexity: O(1).
+func (it *iterator[T]) Begin() {
+	it.current = nil
+	it.index = -1
+	it.pendingNext = false
+	it.backward = false
+}
+
+// End repositions the iterator after the last element.
+// Time complexity: O(1).
+func (it *iterator[T]) End() {
+	it.current = nil
+	it.index = it.ring.size
+	it.pendingNext = false
+	it.backward = false
+}
+
+// Next advances the iterator to the next element and reports whether
+// there was one.
+// Time complexity: O(1).
+func (it *iterator[T]) Next() bool {
+	it.backward = false
+
+	if it.pendingNext {
+		it.pendingNext = false
+		return it.current != nil
+	}
+
+	if it.ring.size == 0 {
+		return false
+	}
+
+	if it.current == nil {
+		if it.index != -1 {
+			return false
+		}
+		it.current = it.ring.head
+		it.index = 0
+		return true
+	}
+
+	if it.index == it.ring.size-1 {
+		return false
+	}
+
+	it.current = it.current.next
+	it.index += 1
+
+	return true
+}
+
+// Prev moves the iterator to the previous element and reports whether
+// there was one.
+// Time complexity: O(1).
+func (it *iterator[T]) Prev() bool {
+	it.backward = true
+
+	if it.pendingNext {
+		it.pendingNext = false
+		return it.current != nil
+	}
+
+	if it.ring.size == 0 {
+		return false
+	}
+
+	if it.current == nil {
+		if it.index != it.ring.size {
+			return false
+		}
+		it.current = it.ring.head.prev
+		it.index = it.ring.size - 1
+		return true
+	}
+
+	if it.index == 0 {
+		return false
+	}
+
+	it.current = it.current.prev
+	it.index -= 1
+
+	return true
+}
+
+// Index returns the index of the current element.
+// Time complexity: O(1).
+func (it *iterator[T]) Index() int {
+	return it.index
+}
+
+// Value returns the value of the current element.
+// Time complexity: O(1).
+func (it *iterator[T]) Value() T {
+	return it.current.value
+}
+
+// Set updates the value of the current element.
+// Time complexity: O(1).
+func (it *iterator[T]) Set(v T) {
+	it.current.value = v
+}
+
+// Remove removes the current element from the underlying ring. The
+// neighbor in the direction of travel (tracked via the last Next/Prev
+// call) becomes current, and pendingNext marks it as not yet visited, so
+// the following Next (or Prev) lands on it instead of skipping past it.
+// Removing the lap's last element in that direction exhausts the
+// iterator instead of wrapping back onto an already visited node, so a
+// conditional-remove loop still visits every surviving element exactly
+// once, in either direction.
+// Time complexity: O(1).
+func (it *iterator[T]) Remove() {
+	if it.current == nil {
+		return
+	}
+
+	n := it.current
+	next := n.next
+	wasHead := n == it.ring.head
+	wasTail := it.index == it.ring.size-1
+
+	var replacement *node[T]
+	var exhausted bool
+	if it.backward {
+		replacement = n.prev
+		exhausted = wasHead && !wasTail
+	} else {
+		replacement = next
+		exhausted = wasTail && !wasHead
+	}
+
+	if it.ring.size == 1 {
+		it.ring.head = nil
+	} else {
+		if wasHead {
+			it.ring.head = next
+		}
+		n.prev.next = n.next
+		n.next.prev = n.prev
+	}
+	it.ring.size -= 1
+
+	if it.ring.size == 0 || exhausted {
+		it.current = nil
+		it.index = it.ring.size
+		if it.backward {
+			it.index = -1
+		}
+		it.pendingNext = false
+		return
+	}
+
+	it.current = replacement
+	it.pendingNext = true
+	if it.backward {
+		it.index -= 1
+	} else if wasHead {
+		it.index = 0
+	}
+}
@@ -0,0 +1,8 @@
+package ring
+
+// node represents an element in the ring: last.next == first and
+// first.prev == last always hold for a non-empty ring.
+type node[T comparable] struct {
+	value      T
+	next, prev *node[T]
+}
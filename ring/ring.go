@@ -0,0 +1,434 @@
+// Package ring implements a circular linked list, mirroring the standard
+// library's container/ring capability that this module otherwise lacks.
+//
+// Ring satisfies linkedlist.List[T] for index-based access, with indices
+// taken modulo Size() so Get/Set never go out of bounds on a non-empty
+// ring. On top of that it exposes ring-specific operations: Rotate to
+// change which element is logically first, Do to walk exactly one lap,
+// and Link/Unlink to splice whole ring segments together in O(1) (plus
+// the O(n) needed to locate the splice point for Unlink).
+//
+// Ring can also act as a fixed-size ring buffer: construct one with
+// NewFixed, and once it holds capacity elements, further Adds overwrite
+// the oldest element instead of growing the ring.
+//
+// The ring is not thread-safe.
+//
+// Example:
+//
+//	r := ring.New[int](1, 2, 3)
+//	r.Rotate(1)
+//	r.Values() // []int{2, 3, 1}
+package ring
+
+import (
+	"fmt"
+
+	"github.com/Sergey-Polishchenko/go-data-structures/containers"
+	"github.com/Sergey-Polishchenko/go-data-structures/containers/serialization"
+	"github.com/Sergey-Polishchenko/go-data-structures/errors"
+	"github.com/Sergey-Polishchenko/go-data-structures/linkedlist"
+)
+
+// Assert Ring implementation for checkout List implementation
+var _ linkedlist.List[int] = (*Ring[int])(nil)
+
+// Assert Ring satisfies the shared container and JSON (de)serialization interfaces.
+var (
+	_ containers.Container[int]           = (*Ring[int])(nil)
+	_ serialization.JSONSerializer[int]   = (*Ring[int])(nil)
+	_ serialization.JSONDeserializer[int] = (*Ring[int])(nil)
+)
+
+// Ring represents a circular linked list of generic comparable values.
+// head marks index 0; Rotate moves it, changing which element that is.
+type Ring[T comparable] struct {
+	head     *node[T]
+	size     int
+	capacity int // 0 means unbounded
+}
+
+// New creates a new empty, unbounded Ring. Optional initial values can
+// be provided.
+// Time complexity: O(1) (or O(n) if values are provided).
+func New[T comparable](values ...T) *Ring[T] {
+	r := &Ring[T]{}
+	if len(values) > 0 {
+		r.Add(values...)
+	}
+	return r
+}
+
+// NewFixed creates a new empty Ring with a fixed capacity. Once the ring
+// holds capacity elements, further Adds overwrite the oldest element
+// (the one at index 0) instead of growing the ring.
+// Time complexity: O(1).
+func NewFixed[T comparable](capacity int) *Ring[T] {
+	return &Ring[T]{capacity: capacity}
+}
+
+// Size returns the number of elements in the ring.
+// Time complexity: O(1).
+func (r *Ring[T]) Size() int {
+	return r.size
+}
+
+// IsEmpty checks if the ring has no elements.
+// Time complexity: O(1).
+func (r *Ring[T]) IsEmpty() bool {
+	return r.size == 0
+}
+
+// Empty is an alias for IsEmpty, satisfying containers.Container[T].
+// Time complexity: O(1).
+func (r *Ring[T]) Empty() bool {
+	return r.IsEmpty()
+}
+
+// Clear removes all elements from the ring.
+// Time complexity: O(1).
+func (r *Ring[T]) Clear() {
+	r.head = nil
+	r.size = 0
+}
+
+// String returns a human-readable representation of the ring.
+// Time complexity: O(n).
+func (r *Ring[T]) String() string {
+	return fmt.Sprintf("%v", r.Values())
+}
+
+// ToJSON encodes the ring's values as a JSON array, starting at index 0.
+// Time complexity: O(n).
+func (r *Ring[T]) ToJSON() ([]byte, error) {
+	return serialization.MarshalJSON[T](r)
+}
+
+// FromJSON replaces the ring's contents with the values decoded from a
+// JSON array produced by ToJSON.
+// Time complexity: O(n).
+func (r *Ring[T]) FromJSON(data []byte) error {
+	return serialization.UnmarshalJSON[T](data, r)
+}
+
+// pushBack inserts a new node holding v immediately before head (i.e. at
+// the end of the ring) and returns it.
+func (r *Ring[T]) pushBack(v T) *node[T] {
+	n := &node[T]{value: v}
+	if r.head == nil {
+		n.next, n.prev = n, n
+		r.head = n
+	} else {
+		link(n, r.head.prev)
+	}
+	r.size += 1
+	return n
+}
+
+// link splices n into the ring immediately after at.
+func link[T comparable](n, at *node[T]) {
+	n.prev = at
+	n.next = at.next
+	n.prev.next = n
+	n.next.prev = n
+}
+
+// Add appends one or more values to the end of the ring. If the ring has
+// a fixed capacity and is already full, each value overwrites the
+// oldest element (the one at index 0) instead of growing the ring.
+// Time complexity: O(n) where n is the number of values added.
+func (r *Ring[T]) Add(values ...T) {
+	for _, v := range values {
+		if r.capacity > 0 && r.size >= r.capacity {
+			r.head.value = v
+			r.head = r.head.next
+			continue
+		}
+		r.pushBack(v)
+	}
+}
+
+// elementAt returns the node at the given index, taken modulo Size().
+// The caller must ensure the ring is not empty.
+func (r *Ring[T]) elementAt(index int) *node[T] {
+	index = ((index % r.size) + r.size) % r.size
+
+	n := r.head
+	for i := 0; i != index; i += 1 {
+		n = n.next
+	}
+
+	return n
+}
+
+// Get returns the value at the specified index, taken modulo Size().
+// Returns ErrEmptyList if called on an empty ring.
+// Time complexity: O(n).
+func (r *Ring[T]) Get(index int) (T, error) {
+	if r.size == 0 {
+		var t T
+		return t, errors.ErrEmptyList
+	}
+	return r.elementAt(index).value, nil
+}
+
+// Values returns a slice of all values in the ring, starting at index 0.
+// Time complexity: O(n).
+func (r *Ring[T]) Values() []T {
+	values := make([]T, r.size)
+	n := r.head
+	for i := 0; i != r.size; i += 1 {
+		values[i] = n.value
+		n = n.next
+	}
+	return values
+}
+
+// IndexOf returns the first index of the specified value.
+// Returns -1 and ErrElementNotFound if value not found.
+// Returns ErrEmptyList if called on an empty ring.
+// Time complexity: O(n).
+func (r *Ring[T]) IndexOf(value T) (int, error) {
+	if r.size == 0 {
+		return -1, errors.ErrEmptyList
+	}
+
+	n := r.head
+	for i := 0; i != r.size; i += 1 {
+		if n.value == value {
+			return i, nil
+		}
+		n = n.next
+	}
+
+	return -1, errors.ErrElementNotFound
+}
+
+// Remove deletes the element at the specified index, taken modulo
+// Size().
+// Returns ErrEmptyList if called on an empty ring.
+// Time complexity: O(n).
+func (r *Ring[T]) Remove(index int) error {
+	if r.size == 0 {
+		return errors.ErrEmptyList
+	}
+
+	n := r.elementAt(index)
+	if r.size == 1 {
+		r.head = nil
+	} else {
+		if n == r.head {
+			r.head = n.next
+		}
+		n.prev.next = n.next
+		n.next.prev = n.prev
+	}
+	r.size -= 1
+
+	return nil
+}
+
+// Contains checks if all specified values exist in the ring.
+// Returns true if all values are present, false otherwise.
+// If no values are provided, returns true (empty set is always a subset).
+// If the ring is empty and values are provided, returns false.
+// Time complexity: O(n + m) where n is ring size and m is the number of values.
+func (r *Ring[T]) Contains(values ...T) bool {
+	if len(values) == 0 {
+		return true
+	}
+	if r.size == 0 || r.size < len(values) {
+		return false
+	}
+
+	valuesToFind := make(map[T]bool)
+	for _, value := range values {
+		valuesToFind[value] = true
+	}
+
+	n := r.head
+	for i := 0; i != r.size; i += 1 {
+		if valuesToFind[n.value] {
+			delete(valuesToFind, n.value)
+			if len(valuesToFind) == 0 {
+				return true
+			}
+		}
+		n = n.next
+	}
+
+	return false
+}
+
+// Swap swaps values of ring nodes by their index, taken modulo Size().
+// Time complexity: O(n).
+func (r *Ring[T]) Swap(i, j int) error {
+	if r.size == 0 {
+		return errors.ErrEmptyList
+	}
+
+	n1, n2 := r.elementAt(i), r.elementAt(j)
+	n1.value, n2.value = n2.value, n1.value
+
+	return nil
+}
+
+// Insert adds one or more values at the specified index.
+// If index is 0, the values are prepended (and become the new index 0).
+// If index equals the ring size, the values are appended.
+// Returns ErrIndexOutOfBounds if index is out of range [0, size].
+// Time complexity: O(n).
+func (r *Ring[T]) Insert(index int, values ...T) error {
+	if !r.inBounds(index) {
+		if index == r.size {
+			r.Add(values...)
+			return nil
+		}
+		return errors.ErrIndexOutOfBounds
+	}
+
+	if index == 0 {
+		at := r.head.prev
+		var first *node[T]
+		for _, v := range values {
+			n := &node[T]{value: v}
+			link(n, at)
+			if first == nil {
+				first = n
+			}
+			at = n
+			r.size += 1
+		}
+		r.head = first
+		return nil
+	}
+
+	at := r.elementAt(index).prev
+	for _, v := range values {
+		n := &node[T]{value: v}
+		link(n, at)
+		at = n
+		r.size += 1
+	}
+
+	return nil
+}
+
+// Set updates the value at the specified index, taken modulo Size().
+// Returns ErrEmptyList if called on an empty ring.
+// Time complexity: O(n).
+func (r *Ring[T]) Set(index int, value T) error {
+	if r.size == 0 {
+		return errors.ErrEmptyList
+	}
+
+	r.elementAt(index).value = value
+
+	return nil
+}
+
+// Rotate moves the ring's logical head forward by n positions, or
+// backward if n is negative, changing which element Get(0) returns.
+// Time complexity: O(|n|).
+func (r *Ring[T]) Rotate(n int) {
+	if r.size == 0 {
+		return
+	}
+
+	if n >= 0 {
+		for i := 0; i < n; i += 1 {
+			r.head = r.head.next
+		}
+	} else {
+		for i := 0; i < -n; i += 1 {
+			r.head = r.head.prev
+		}
+	}
+}
+
+// Do calls fn once for every element in the ring, in order, walking
+// exactly one full loop starting at the current head.
+// Time complexity: O(n).
+func (r *Ring[T]) Do(fn func(T)) {
+	if r.size == 0 {
+		return
+	}
+
+	n := r.head
+	for i := 0; i != r.size; i += 1 {
+		fn(n.value)
+		n = n.next
+	}
+}
+
+// Link splices other onto the end of r and empties other.
+// Does nothing if other is nil or empty.
+// Time complexity: O(1).
+func (r *Ring[T]) Link(other *Ring[T]) {
+	if other == nil || other.size == 0 {
+		return
+	}
+
+	if r.size == 0 {
+		r.head = other.head
+		r.size = other.size
+	} else {
+		rTail := r.head.prev
+		oHead, oTail := other.head, other.head.prev
+
+		rTail.next = oHead
+		oHead.prev = rTail
+		oTail.next = r.head
+		r.head.prev = oTail
+
+		r.size += other.size
+	}
+
+	other.head = nil
+	other.size = 0
+}
+
+// Unlink removes the |n| elements starting at head (or, if n is
+// negative, the |n| elements immediately before head) from r and
+// returns them as a new standalone Ring[T]. Locating the cut point costs
+// O(|n|); the splice itself is O(1). If n is 0 or r is empty, Unlink
+// leaves r untouched and returns an empty Ring[T].
+func (r *Ring[T]) Unlink(n int) *Ring[T] {
+	if n == 0 || r.size == 0 {
+		return &Ring[T]{}
+	}
+
+	if n < 0 {
+		r.Rotate(n)
+		n = -n
+	}
+	if n > r.size {
+		n = r.size
+	}
+
+	start := r.head
+	end := start
+	for i := 1; i < n; i += 1 {
+		end = end.next
+	}
+	afterEnd := end.next
+
+	if n == r.size {
+		r.head = nil
+	} else {
+		r.head = afterEnd
+		r.head.prev = start.prev
+		start.prev.next = r.head
+	}
+	r.size -= n
+
+	start.prev = end
+	end.next = start
+
+	return &Ring[T]{head: start, size: n}
+}
+
+// Check if index is in bounds [0, size).
+func (r *Ring[T]) inBounds(index int) bool {
+	return index >= 0 && index < r.size
+}
@@ -0,0 +1,282 @@
+package ring
+
+import (
+	"testing"
+
+	"github.com/Sergey-Polishchenko/go-data-structures/errors"
+)
+
+type ringTest struct {
+	name        string
+	operation   func(*Ring[int]) (interface{}, error)
+	expected    interface{}
+	expectedErr error
+}
+
+var ringTests = []ringTest{
+	// Add Test
+	{
+		name: "Add to empty ring",
+		operation: func(r *Ring[int]) (interface{}, error) {
+			r.Add(1)
+			return r.Values(), nil
+		},
+		expected:    []int{1},
+		expectedErr: nil,
+	},
+	{
+		name: "Add multiple elements",
+		operation: func(r *Ring[int]) (interface{}, error) {
+			r.Add(1, 2, 3)
+			return r.Values(), nil
+		},
+		expected:    []int{1, 2, 3},
+		expectedErr: nil,
+	},
+
+	// Get Test
+	{
+		name: "Get from empty ring",
+		operation: func(r *Ring[int]) (interface{}, error) {
+			return r.Get(0)
+		},
+		expected:    0,
+		expectedErr: errors.ErrEmptyList,
+	},
+	{
+		name: "Get wraps modulo size",
+		operation: func(r *Ring[int]) (interface{}, error) {
+			r.Add(1, 2, 3)
+			return r.Get(4)
+		},
+		expected:    2,
+		expectedErr: nil,
+	},
+
+	// Remove Test
+	{
+		name: "Remove from empty ring",
+		operation: func(r *Ring[int]) (interface{}, error) {
+			return nil, r.Remove(0)
+		},
+		expected:    nil,
+		expectedErr: errors.ErrEmptyList,
+	},
+	{
+		name: "Remove first element",
+		operation: func(r *Ring[int]) (interface{}, error) {
+			r.Add(1, 2, 3)
+			return nil, r.Remove(0)
+		},
+		expected:    []int{2, 3},
+		expectedErr: nil,
+	},
+
+	// Contains Test
+	{
+		name: "Contains all elements",
+		operation: func(r *Ring[int]) (interface{}, error) {
+			r.Add(1, 2, 3)
+			return r.Contains(1, 2), nil
+		},
+		expected:    true,
+		expectedErr: nil,
+	},
+}
+
+func TestRing(t *testing.T) {
+	for _, tt := range ringTests {
+		t.Run(
+			tt.name,
+			func(t *testing.T) {
+				r := New[int]()
+				result, err := tt.operation(r)
+
+				if err != tt.expectedErr {
+					t.Errorf("Error: got %v, want %v", err, tt.expectedErr)
+				}
+
+				switch v := result.(type) {
+				case []int:
+					if !sliceEqual(v, tt.expected.([]int)) {
+						t.Errorf("Values: got %v, want %v", v, tt.expected)
+					}
+				case int:
+					if v != tt.expected.(int) {
+						t.Errorf("Value: got %d, want %d", v, tt.expected)
+					}
+				case bool:
+					if v != tt.expected.(bool) {
+						t.Errorf("Bool: got %t, want %t", v, tt.expected)
+					}
+				}
+
+				if tt.expectedErr == nil && tt.expected != nil {
+					if values, ok := tt.expected.([]int); ok {
+						if !sliceEqual(r.Values(), values) {
+							t.Errorf("After operation: got %v, want %v", r.Values(), values)
+						}
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	r := New[int](1, 2, 3, 4)
+
+	r.Rotate(1)
+	if !sliceEqual(r.Values(), []int{2, 3, 4, 1}) {
+		t.Errorf("Rotate(1): got %v, want %v", r.Values(), []int{2, 3, 4, 1})
+	}
+
+	r.Rotate(-2)
+	if !sliceEqual(r.Values(), []int{4, 1, 2, 3}) {
+		t.Errorf("Rotate(-2): got %v, want %v", r.Values(), []int{4, 1, 2, 3})
+	}
+}
+
+func TestDo(t *testing.T) {
+	r := New[int](1, 2, 3)
+
+	var got []int
+	r.Do(func(v int) {
+		got = append(got, v)
+	})
+	if !sliceEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Do: got %v, want %v", got, []int{1, 2, 3})
+	}
+}
+
+func TestLinkAndUnlink(t *testing.T) {
+	r1 := New[int](1, 2, 3)
+	r2 := New[int](4, 5)
+
+	r1.Link(r2)
+	if !sliceEqual(r1.Values(), []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("Link: got %v, want %v", r1.Values(), []int{1, 2, 3, 4, 5})
+	}
+	if r2.Size() != 0 {
+		t.Errorf("Link: expected other to be emptied, got size %d", r2.Size())
+	}
+
+	segment := r1.Unlink(2)
+	if !sliceEqual(r1.Values(), []int{3, 4, 5}) {
+		t.Errorf("Unlink: got %v, want %v", r1.Values(), []int{3, 4, 5})
+	}
+	if !sliceEqual(segment.Values(), []int{1, 2}) {
+		t.Errorf("Unlink segment: got %v, want %v", segment.Values(), []int{1, 2})
+	}
+}
+
+func TestFixedCapacityOverwritesOldest(t *testing.T) {
+	r := NewFixed[int](3)
+
+	r.Add(1, 2, 3)
+	if !sliceEqual(r.Values(), []int{1, 2, 3}) {
+		t.Fatalf("Add up to capacity: got %v, want %v", r.Values(), []int{1, 2, 3})
+	}
+
+	r.Add(4)
+	if r.Size() != 3 {
+		t.Fatalf("Add past capacity: expected size to stay 3, got %d", r.Size())
+	}
+	if !sliceEqual(r.Values(), []int{2, 3, 4}) {
+		t.Errorf("Add past capacity: got %v, want %v", r.Values(), []int{2, 3, 4})
+	}
+}
+
+func TestIterator(t *testing.T) {
+	r := New[int](1, 2, 3)
+
+	it := r.Iterator()
+
+	var got []int
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+	if !sliceEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Next: got %v, want %v", got, []int{1, 2, 3})
+	}
+
+	var back []int
+	for it.Prev() {
+		back = append(back, it.Value())
+	}
+	if !sliceEqual(back, []int{2, 1}) {
+		t.Errorf("Prev: got %v, want %v", back, []int{2, 1})
+	}
+}
+
+func TestIteratorRemove(t *testing.T) {
+	r := New[int](1, 2, 3)
+
+	it := r.Iterator()
+	it.Next()
+	it.Next()
+	it.Next() // positioned on the last element, value 3
+	it.Remove()
+
+	if !sliceEqual(r.Values(), []int{1, 2}) {
+		t.Errorf("Values after Remove: got %v, want %v", r.Values(), []int{1, 2})
+	}
+	if it.Next() {
+		t.Errorf("Next: removing the last element of the lap should exhaust the iterator, got value %v", it.Value())
+	}
+}
+
+func TestIteratorRemoveToCompletion(t *testing.T) {
+	r := New[int](1, 2, 3, 4, 5)
+
+	it := r.Iterator()
+	var visited []int
+	for it.Next() {
+		v := it.Value()
+		visited = append(visited, v)
+		if v%2 == 0 {
+			it.Remove()
+		}
+	}
+
+	if !sliceEqual(visited, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("visited: got %v, want %v", visited, []int{1, 2, 3, 4, 5})
+	}
+	if !sliceEqual(r.Values(), []int{1, 3, 5}) {
+		t.Errorf("Values after removal loop: got %v, want %v", r.Values(), []int{1, 3, 5})
+	}
+}
+
+func TestIteratorRemoveToCompletionBackward(t *testing.T) {
+	r := New[int](1, 2, 3, 4)
+
+	it := r.Iterator()
+	it.End()
+	var visited []int
+	for it.Prev() {
+		v := it.Value()
+		visited = append(visited, v)
+		if v == 3 {
+			it.Remove()
+		}
+	}
+
+	if !sliceEqual(visited, []int{4, 3, 2, 1}) {
+		t.Errorf("visited: got %v, want %v", visited, []int{4, 3, 2, 1})
+	}
+	if !sliceEqual(r.Values(), []int{1, 2, 4}) {
+		t.Errorf("Values after removal loop: got %v, want %v", r.Values(), []int{1, 2, 4})
+	}
+}
+
+func sliceEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}